@@ -0,0 +1,179 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/fastly/go-fastly/v9/fastly"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ConfigStoreEntryResource{}
+var _ resource.ResourceWithImportState = &ConfigStoreEntryResource{}
+
+func NewConfigStoreEntryResource() resource.Resource {
+	return &ConfigStoreEntryResource{}
+}
+
+// ConfigStoreEntryResource defines the resource implementation.
+type ConfigStoreEntryResource struct {
+	client *fastly.Client
+}
+
+// ConfigStoreEntryModel describes the resource data model.
+type ConfigStoreEntryModel struct {
+	StoreID types.String `tfsdk:"store_id"`
+	Key     types.String `tfsdk:"key"`
+	Value   types.String `tfsdk:"value"`
+}
+
+func (r *ConfigStoreEntryResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_configstore_entry"
+}
+
+func (r *ConfigStoreEntryResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Entries into a Fastly ConfigStore",
+
+		Attributes: map[string]schema.Attribute{
+			"store_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the store to insert the entry.",
+				Required:            true,
+			},
+			"key": schema.StringAttribute{
+				MarkdownDescription: "The key of the entry",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"value": schema.StringAttribute{
+				MarkdownDescription: "The value of the entry. Unlike secretstore entries, configstore values are not sensitive.",
+				Required:            true,
+			},
+		},
+	}
+}
+
+func (r *ConfigStoreEntryResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*FastlyProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *FastlyProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+func (r *ConfigStoreEntryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ConfigStoreEntryModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.CreateConfigStoreItem(&fastly.CreateConfigStoreItemInput{
+		StoreID: data.StoreID.ValueString(),
+		Key:     data.Key.ValueString(),
+		Value:   data.Value.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("failed to create configstore entry with fastly", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ConfigStoreEntryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ConfigStoreEntryModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	item, err := r.client.GetConfigStoreItem(&fastly.GetConfigStoreItemInput{
+		StoreID: data.StoreID.ValueString(),
+		Key:     data.Key.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"could not get configstore entry with key "+data.Key.ValueString()+" from store "+data.StoreID.ValueString(),
+			err.Error(),
+		)
+		return
+	}
+
+	data.Value = types.StringValue(item.Value)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ConfigStoreEntryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ConfigStoreEntryModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.UpdateConfigStoreItem(&fastly.UpdateConfigStoreItemInput{
+		StoreID: data.StoreID.ValueString(),
+		Key:     data.Key.ValueString(),
+		Value:   data.Value.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("failed to update configstore entry with key "+data.Key.ValueString(), err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ConfigStoreEntryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ConfigStoreEntryModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteConfigStoreItem(&fastly.DeleteConfigStoreItemInput{
+		StoreID: data.StoreID.ValueString(),
+		Key:     data.Key.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("failed to delete configstore entry with key "+data.Key.ValueString(), err.Error())
+		return
+	}
+}
+
+func (r *ConfigStoreEntryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, ".")
+	if len(parts) != 2 {
+		resp.Diagnostics.AddError(
+			"invalid import ID",
+			fmt.Sprintf("expected an import ID of the form \"store_id.key\", got %q", req.ID),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("store_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("key"), parts[1])...)
+}
@@ -0,0 +1,193 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/fastly/go-fastly/v9/fastly"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &KVStoreEntryResource{}
+var _ resource.ResourceWithImportState = &KVStoreEntryResource{}
+
+func NewKVStoreEntryResource() resource.Resource {
+	return &KVStoreEntryResource{}
+}
+
+// KVStoreEntryResource defines the resource implementation.
+type KVStoreEntryResource struct {
+	client *fastly.Client
+}
+
+// KVStoreEntryModel describes the resource data model.
+type KVStoreEntryModel struct {
+	StoreID     types.String `tfsdk:"store_id"`
+	Key         types.String `tfsdk:"key"`
+	ValueBase64 types.String `tfsdk:"value_base64"`
+}
+
+func (r *KVStoreEntryResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kvstore_entry"
+}
+
+func (r *KVStoreEntryResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Entries into a Fastly KVStore",
+
+		Attributes: map[string]schema.Attribute{
+			"store_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the store to insert the entry.",
+				Required:            true,
+			},
+			"key": schema.StringAttribute{
+				MarkdownDescription: "The key of the entry",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"value_base64": schema.StringAttribute{
+				MarkdownDescription: "The base64-encoded value of the entry. KVStore values may be arbitrary binary data.",
+				Required:            true,
+				Sensitive:           true,
+			},
+		},
+	}
+}
+
+func (r *KVStoreEntryResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*FastlyProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *FastlyProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+func (r *KVStoreEntryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data KVStoreEntryModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	value, err := base64.StdEncoding.DecodeString(data.ValueBase64.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("value_base64 is not valid base64", err.Error())
+		return
+	}
+
+	err = r.client.InsertKVStoreKey(&fastly.InsertKVStoreKeyInput{
+		StoreID: data.StoreID.ValueString(),
+		Key:     data.Key.ValueString(),
+		Value:   string(value),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("failed to create kvstore entry with fastly", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *KVStoreEntryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data KVStoreEntryModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	value, err := r.client.GetKVStoreKey(&fastly.GetKVStoreKeyInput{
+		StoreID: data.StoreID.ValueString(),
+		Key:     data.Key.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"could not get kvstore entry with key "+data.Key.ValueString()+" from store "+data.StoreID.ValueString(),
+			err.Error(),
+		)
+		return
+	}
+
+	data.ValueBase64 = types.StringValue(base64.StdEncoding.EncodeToString([]byte(value)))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *KVStoreEntryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data KVStoreEntryModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	value, err := base64.StdEncoding.DecodeString(data.ValueBase64.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("value_base64 is not valid base64", err.Error())
+		return
+	}
+
+	err = r.client.InsertKVStoreKey(&fastly.InsertKVStoreKeyInput{
+		StoreID: data.StoreID.ValueString(),
+		Key:     data.Key.ValueString(),
+		Value:   string(value),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("failed to update kvstore entry with key "+data.Key.ValueString(), err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *KVStoreEntryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data KVStoreEntryModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteKVStoreKey(&fastly.DeleteKVStoreKeyInput{
+		StoreID: data.StoreID.ValueString(),
+		Key:     data.Key.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("failed to delete kvstore entry with key "+data.Key.ValueString(), err.Error())
+		return
+	}
+}
+
+func (r *KVStoreEntryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, ".")
+	if len(parts) != 2 {
+		resp.Diagnostics.AddError(
+			"invalid import ID",
+			fmt.Sprintf("expected an import ID of the form \"store_id.key\", got %q", req.ID),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("store_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("key"), parts[1])...)
+}
@@ -0,0 +1,138 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/fastly/go-fastly/v9/fastly"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &SecretStoreDataSource{}
+
+func NewSecretStoreDataSource() datasource.DataSource {
+	return &SecretStoreDataSource{}
+}
+
+// SecretStoreDataSource defines the data source implementation.
+type SecretStoreDataSource struct {
+	client *fastly.Client
+}
+
+// SecretStoreDataSourceModel describes the data source data model.
+type SecretStoreDataSourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	CreatedAt types.String `tfsdk:"created_at"`
+}
+
+func (d *SecretStoreDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_secretstore"
+}
+
+func (d *SecretStoreDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Looks up a Fastly SecretStore by id or name.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the store. Either `id` or `name` must be set.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the store. Either `id` or `name` must be set.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Date and time when this store was created in ISO 8601.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *SecretStoreDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*FastlyProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *FastlyProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+func (d *SecretStoreDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SecretStoreDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ID.ValueString() == "" && data.Name.ValueString() == "" {
+		resp.Diagnostics.AddError("missing lookup attribute", "one of `id` or `name` must be set to look up a secretstore")
+		return
+	}
+
+	store, err := d.lookup(data)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to look up secretstore", err.Error())
+		return
+	}
+
+	data.ID = types.StringValue(store.StoreID)
+	data.Name = types.StringValue(store.Name)
+	data.CreatedAt = types.StringValue(store.CreatedAt.Format(time.RFC3339))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *SecretStoreDataSource) lookup(data SecretStoreDataSourceModel) (*fastly.SecretStore, error) {
+	if data.ID.ValueString() != "" {
+		return d.client.GetSecretStore(&fastly.GetSecretStoreInput{
+			StoreID: data.ID.ValueString(),
+		})
+	}
+
+	return findSecretStoreByName(d.client, data.Name.ValueString())
+}
+
+// findSecretStoreByName resolves a SecretStore by its human-readable name,
+// since the Fastly API only looks stores up by ID. Shared by the
+// secretstore data source and by secretstore_entry's ImportState, which
+// both need to turn a name a practitioner typed into the StoreID the API
+// actually wants.
+func findSecretStoreByName(client *fastly.Client, name string) (*fastly.SecretStore, error) {
+	stores, err := client.ListSecretStores(&fastly.ListSecretStoresInput{
+		Name: name,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, store := range stores.Data {
+		if store.Name == name {
+			return &store, nil
+		}
+	}
+
+	return nil, fmt.Errorf("no secretstore found with name %q", name)
+}
@@ -0,0 +1,147 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/fastly/go-fastly/v9/fastly"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &SecretStoreEntriesDataSource{}
+
+func NewSecretStoreEntriesDataSource() datasource.DataSource {
+	return &SecretStoreEntriesDataSource{}
+}
+
+// SecretStoreEntriesDataSource defines the data source implementation.
+type SecretStoreEntriesDataSource struct {
+	client *fastly.Client
+}
+
+// SecretStoreEntriesDataSourceModel describes the data source data model.
+type SecretStoreEntriesDataSourceModel struct {
+	StoreID types.String                `tfsdk:"store_id"`
+	Entries []SecretStoreEntryListModel `tfsdk:"entries"`
+}
+
+// SecretStoreEntryListModel describes a single entry as returned by the plural data source.
+type SecretStoreEntryListModel struct {
+	Key       types.String `tfsdk:"key"`
+	Digest    types.String `tfsdk:"digest"`
+	CreatedAt types.String `tfsdk:"created_at"`
+}
+
+func (d *SecretStoreEntriesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_secretstore_entries"
+}
+
+func (d *SecretStoreEntriesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Lists every key in a Fastly SecretStore. Secret values are never returned; use `digest` to detect drift.",
+
+		Attributes: map[string]schema.Attribute{
+			"store_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the store to list entries from.",
+				Required:            true,
+			},
+			"entries": schema.ListNestedAttribute{
+				MarkdownDescription: "The entries found in the store.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"key": schema.StringAttribute{
+							MarkdownDescription: "The key of the secret.",
+							Computed:            true,
+						},
+						"digest": schema.StringAttribute{
+							MarkdownDescription: "Fastly digest of the secret. Used to detect drift.",
+							Computed:            true,
+						},
+						"created_at": schema.StringAttribute{
+							MarkdownDescription: "Date and time when this secret was created in ISO 8601.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *SecretStoreEntriesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*FastlyProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *FastlyProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+func (d *SecretStoreEntriesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SecretStoreEntriesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	entries, err := d.listAllSecrets(data.StoreID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to list secrets in store "+data.StoreID.ValueString(), err.Error())
+		return
+	}
+
+	data.Entries = entries
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// listAllSecrets pages through every secret in a store, following the
+// cursor returned by the Fastly API until it is exhausted.
+func (d *SecretStoreEntriesDataSource) listAllSecrets(storeID string) ([]SecretStoreEntryListModel, error) {
+	var entries []SecretStoreEntryListModel
+	cursor := ""
+
+	for {
+		secrets, err := d.client.ListSecrets(&fastly.ListSecretsInput{
+			StoreID: storeID,
+			Cursor:  cursor,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, secret := range secrets.Data {
+			entries = append(entries, SecretStoreEntryListModel{
+				Key:       types.StringValue(secret.Name),
+				Digest:    types.StringValue(hex.EncodeToString(secret.Digest)),
+				CreatedAt: types.StringValue(secret.CreatedAt.Format(time.RFC3339)),
+			})
+		}
+
+		if secrets.Meta.NextCursor == "" {
+			break
+		}
+		cursor = secrets.Meta.NextCursor
+	}
+
+	return entries, nil
+}
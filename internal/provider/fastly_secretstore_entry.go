@@ -5,8 +5,10 @@ package provider
 
 import (
 	"context"
+	"crypto/sha256"
 	"encoding/hex"
 	"fmt"
+	"os"
 	"strings"
 	"time"
 
@@ -20,9 +22,21 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+const (
+	secretModePlaintext       = "plaintext"
+	secretModeClientEncrypted = "client_encrypted"
+
+	// lastKnownDigestPrivateKey stores the digest Fastly returned the last
+	// time we wrote `value`, so ModifyPlan can tell a secret that was
+	// rotated out-of-band (digest changed without us writing it) from one
+	// that's simply being read back unchanged.
+	lastKnownDigestPrivateKey = "last_known_digest"
+)
+
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &SecretStoreEntriesResource{}
 var _ resource.ResourceWithImportState = &SecretStoreEntriesResource{}
+var _ resource.ResourceWithModifyPlan = &SecretStoreEntriesResource{}
 
 func NewSecretStoreEntriesResource() resource.Resource {
 	return &SecretStoreEntriesResource{}
@@ -30,16 +44,21 @@ func NewSecretStoreEntriesResource() resource.Resource {
 
 // SecretStoreEntriesResource defines the resource implementation.
 type SecretStoreEntriesResource struct {
-	client *fastly.Client
+	client            *fastly.Client
+	defaultSecretMode string
 }
 
 // SecretStoreEntriesModel describes the resource data model.
 type SecretStoreEntriesModel struct {
-	StoreID   types.String `tfsdk:"store_id"`
-	Key       types.String `tfsdk:"key"`
-	Value     types.String `tfsdk:"value"`
-	Digest    types.String `tfsdk:"digest"`
-	CreatedAt types.String `tfsdk:"created_at"`
+	StoreID            types.String `tfsdk:"store_id"`
+	Key                types.String `tfsdk:"key"`
+	Value              types.String `tfsdk:"value"`
+	Mode               types.String `tfsdk:"mode"`
+	Digest             types.String `tfsdk:"digest"`
+	ValueSHA256        types.String `tfsdk:"value_sha256"`
+	CreatedAt          types.String `tfsdk:"created_at"`
+	ClientKeySignature types.String `tfsdk:"client_key_signature"`
+	ClientKeyExpiresAt types.String `tfsdk:"client_key_expires_at"`
 }
 
 func (r *SecretStoreEntriesResource) augmentStateFromSecret(ctx context.Context, secret *fastly.Secret, model *SecretStoreEntriesModel) {
@@ -68,19 +87,43 @@ func (r *SecretStoreEntriesResource) Schema(ctx context.Context, req resource.Sc
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			// Not write-only: that requires terraform-plugin-framework
+			// v1.14+, and this provider is pinned to v1.13.0. See
+			// CHANGELOG.md for why the bump is currently blocked.
 			"value": schema.StringAttribute{
-				MarkdownDescription: "The value of the secret",
+				MarkdownDescription: "The value of the secret.",
 				Required:            true,
 				Sensitive:           true,
 			},
+			"mode": schema.StringAttribute{
+				MarkdownDescription: "How `value` is transmitted to Fastly. `plaintext` sends it as-is; `client_encrypted` seals it client-side with NaCl box against the store's client key before it ever leaves this host. Defaults to the provider's `default_secret_mode`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
 			"digest": schema.StringAttribute{
 				MarkdownDescription: "Fastly digest of the secret. Used to detect drift.",
 				Computed:            true,
 			},
+			"value_sha256": schema.StringAttribute{
+				MarkdownDescription: "SHA-256 hex digest of the plaintext `value` as last written by Terraform. Exposed so external tooling can assert the deployed secret's content without reading it back.",
+				Computed:            true,
+			},
 			"created_at": schema.StringAttribute{
 				MarkdownDescription: "Date and time  when this secret was created in ISO 8601.",
 				Computed:            true,
 			},
+			"client_key_signature": schema.StringAttribute{
+				MarkdownDescription: "Signature of the client key used to seal `value` in `client_encrypted` mode. Empty in `plaintext` mode.",
+				Computed:            true,
+			},
+			"client_key_expires_at": schema.StringAttribute{
+				MarkdownDescription: "Expiry, in ISO 8601, of the client key used to seal `value`. Empty in `plaintext` mode.",
+				Computed:            true,
+			},
 		},
 	}
 }
@@ -90,18 +133,56 @@ func (r *SecretStoreEntriesResource) Configure(ctx context.Context, req resource
 		return
 	}
 
-	client, ok := req.ProviderData.(*fastly.Client)
+	providerData, ok := req.ProviderData.(*FastlyProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *FastlyProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = providerData.Client
+	r.defaultSecretMode = providerData.DefaultSecretMode
+}
+
+// prepareSecret returns the secret bytes and, if non-nil, the client key
+// public key to submit to Fastly as CreateSecretInput.Secret/ClientKey. It
+// seals the plaintext when mode is client_encrypted and records the client
+// key used so state reflects what was actually sent. go-fastly only exposes
+// CreateClientKey (there is no way to fetch the store's current one), so a
+// fresh, short-lived client key is minted for every client-encrypted write.
+func (r *SecretStoreEntriesResource) prepareSecret(data *SecretStoreEntriesModel) ([]byte, []byte, error) {
+	plaintext := data.Value.ValueString()
+	data.ValueSHA256 = types.StringValue(sha256Hex(plaintext))
+
+	if data.Mode.ValueString() != secretModeClientEncrypted {
+		data.ClientKeySignature = types.StringValue("")
+		data.ClientKeyExpiresAt = types.StringValue("")
+		return []byte(plaintext), nil, nil
+	}
+
+	key, err := r.client.CreateClientKey()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create secretstore client key: %w", err)
+	}
+
+	sealed, err := key.Encrypt([]byte(plaintext))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to seal secret value: %w", err)
+	}
+
+	data.ClientKeySignature = types.StringValue(hex.EncodeToString(key.Signature))
+	data.ClientKeyExpiresAt = types.StringValue(key.ExpiresAt.Format(time.RFC3339))
+
+	return sealed, key.PublicKey, nil
+}
+
+func sha256Hex(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:])
 }
 
 func (r *SecretStoreEntriesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -111,11 +192,18 @@ func (r *SecretStoreEntriesResource) Create(ctx context.Context, req resource.Cr
 		return
 	}
 
+	secretBytes, clientKeyPublic, err := r.prepareSecret(&data)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to prepare secret value", err.Error())
+		return
+	}
+
 	secret, err := r.client.CreateSecret(&fastly.CreateSecretInput{
-		Method:  "POST",
-		Name:    data.Key.ValueString(),
-		Secret:  []byte(data.Value.ValueString()),
-		StoreID: data.StoreID.ValueString(),
+		Method:    "POST",
+		Name:      data.Key.ValueString(),
+		Secret:    secretBytes,
+		StoreID:   data.StoreID.ValueString(),
+		ClientKey: clientKeyPublic,
 	})
 	if err != nil {
 		resp.Diagnostics.AddError("failed to create secret with fastly", err.Error())
@@ -123,6 +211,7 @@ func (r *SecretStoreEntriesResource) Create(ctx context.Context, req resource.Cr
 	}
 
 	r.augmentStateFromSecret(ctx, secret, &data)
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, lastKnownDigestPrivateKey, secret.Digest)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -156,11 +245,18 @@ func (r *SecretStoreEntriesResource) Update(ctx context.Context, req resource.Up
 		return
 	}
 
+	secretBytes, clientKeyPublic, err := r.prepareSecret(&data)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to prepare secret value", err.Error())
+		return
+	}
+
 	secret, err := r.client.CreateSecret(&fastly.CreateSecretInput{
-		Method:  "PATCH",
-		Name:    data.Key.ValueString(),
-		Secret:  []byte(data.Value.ValueString()),
-		StoreID: data.StoreID.ValueString(),
+		Method:    "PATCH",
+		Name:      data.Key.ValueString(),
+		Secret:    secretBytes,
+		StoreID:   data.StoreID.ValueString(),
+		ClientKey: clientKeyPublic,
 	})
 	if err != nil {
 		resp.Diagnostics.AddError("failed to update secret with key "+data.Key.ValueString(), err.Error())
@@ -168,6 +264,7 @@ func (r *SecretStoreEntriesResource) Update(ctx context.Context, req resource.Up
 	}
 
 	r.augmentStateFromSecret(ctx, secret, &data)
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, lastKnownDigestPrivateKey, secret.Digest)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -188,8 +285,116 @@ func (r *SecretStoreEntriesResource) Delete(ctx context.Context, req resource.De
 	}
 }
 
+// ModifyPlan catches secrets that were rotated directly against the Fastly
+// API (outside Terraform). Read always refreshes `digest` to whatever
+// Fastly currently has, so by itself a drifted secret looks identical to
+// one nobody ever touched. We can't recompute Fastly's digest locally (it's
+// a hash of ciphertext + salt, not of our plaintext), so instead we keep
+// our own record, in private state, of the digest we saw right after our
+// own last write. If the freshly-refreshed digest no longer matches that
+// record, something else wrote to the store and the only safe remediation
+// is to replace the entry.
+func (r *SecretStoreEntriesResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		// Destroy; nothing to plan.
+		return
+	}
+
+	if req.State.Raw.IsNull() {
+		// Create: apply the provider's default_secret_mode when the
+		// practitioner left `mode` unset. This has to happen here rather
+		// than via a schema PlanModifier, because Server.ResourceSchema
+		// builds and caches the schema from a one-off resource instance
+		// that is never Configure'd - a modifier capturing `r` there would
+		// always see a zero-value defaultSecretMode.
+		var config SecretStoreEntriesModel
+		resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if config.Mode.IsNull() {
+			mode := r.defaultSecretMode
+			if mode == "" {
+				mode = secretModePlaintext
+			}
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("mode"), mode)...)
+		}
+		return
+	}
+
+	var state SecretStoreEntriesModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	lastKnownDigest, diags := req.Private.GetKey(ctx, lastKnownDigestPrivateKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if lastKnownDigest == nil {
+		// No record yet, e.g. an entry imported before this check existed.
+		return
+	}
+
+	if hex.EncodeToString(lastKnownDigest) == state.Digest.ValueString() {
+		return
+	}
+
+	resp.RequiresReplace = append(resp.RequiresReplace, path.Root("value"))
+}
+
+// importIDSeparator returns the separator ImportState splits import IDs on.
+// It defaults to "/" rather than "." so that keys containing dots (common
+// in DNS-style secret names, e.g. "svc.prod.tls.key") can be imported
+// unambiguously. Override with FASTLY_IMPORT_ID_SEPARATOR if "/" itself
+// needs to appear in a key.
+func importIDSeparator() string {
+	if sep := os.Getenv("FASTLY_IMPORT_ID_SEPARATOR"); sep != "" {
+		return sep
+	}
+	return "/"
+}
+
+// ImportState accepts two forms of import ID, split on importIDSeparator():
+//
+//   - "store_id<sep>key"              — looks the entry up by store ID directly.
+//   - "name<sep>store_name<sep>key"   — resolves store_name to a store ID via
+//     ListSecretStores first, for when the ID isn't known or convenient.
 func (r *SecretStoreEntriesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	parts := strings.Split(req.ID, ".")
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("store_id"), parts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("key"), parts[1])...)
+	sep := importIDSeparator()
+	parts := strings.Split(req.ID, sep)
+
+	switch len(parts) {
+	case 2:
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("store_id"), parts[0])...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("key"), parts[1])...)
+	case 3:
+		if parts[0] != "name" {
+			resp.Diagnostics.AddError(
+				"invalid import ID",
+				fmt.Sprintf("a 3-part import ID must be of the form \"name%sstore_name%skey\", got %q", sep, sep, req.ID),
+			)
+			return
+		}
+
+		store, err := findSecretStoreByName(r.client, parts[1])
+		if err != nil {
+			resp.Diagnostics.AddError("failed to resolve secretstore by name", err.Error())
+			return
+		}
+
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("store_id"), store.StoreID)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("key"), parts[2])...)
+	default:
+		resp.Diagnostics.AddError(
+			"invalid import ID",
+			fmt.Sprintf(
+				"expected an import ID of the form \"store_id%skey\" or \"name%sstore_name%skey\", got %q (%d part(s) when split on %q)",
+				sep, sep, sep, req.ID, len(parts), sep,
+			),
+		)
+	}
 }
@@ -0,0 +1,110 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/fastly/go-fastly/v9/fastly"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &SecretStoreEntryDataSource{}
+
+func NewSecretStoreEntryDataSource() datasource.DataSource {
+	return &SecretStoreEntryDataSource{}
+}
+
+// SecretStoreEntryDataSource defines the data source implementation.
+type SecretStoreEntryDataSource struct {
+	client *fastly.Client
+}
+
+// SecretStoreEntryDataSourceModel describes the data source data model.
+type SecretStoreEntryDataSourceModel struct {
+	StoreID   types.String `tfsdk:"store_id"`
+	Key       types.String `tfsdk:"key"`
+	Digest    types.String `tfsdk:"digest"`
+	CreatedAt types.String `tfsdk:"created_at"`
+}
+
+func (d *SecretStoreEntryDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_secretstore_entry"
+}
+
+func (d *SecretStoreEntryDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Looks up an entry of a Fastly SecretStore. The secret value itself is never returned; use `digest` to detect drift.",
+
+		Attributes: map[string]schema.Attribute{
+			"store_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the store to look up the entry in.",
+				Required:            true,
+			},
+			"key": schema.StringAttribute{
+				MarkdownDescription: "The key of the secret.",
+				Required:            true,
+			},
+			"digest": schema.StringAttribute{
+				MarkdownDescription: "Fastly digest of the secret. Used to detect drift.",
+				Computed:            true,
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Date and time when this secret was created in ISO 8601.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *SecretStoreEntryDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*FastlyProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *FastlyProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+func (d *SecretStoreEntryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SecretStoreEntryDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	secret, err := d.client.GetSecret(&fastly.GetSecretInput{
+		Name:    data.Key.ValueString(),
+		StoreID: data.StoreID.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"could not get secret with name "+data.Key.ValueString()+" from store "+data.StoreID.ValueString(),
+			err.Error(),
+		)
+		return
+	}
+
+	data.Digest = types.StringValue(hex.EncodeToString(secret.Digest))
+	data.CreatedAt = types.StringValue(secret.CreatedAt.Format(time.RFC3339))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
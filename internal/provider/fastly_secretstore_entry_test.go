@@ -0,0 +1,189 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/fastly/go-fastly/v9/fastly"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// importState runs SecretStoreEntriesResource.ImportState against a blank
+// state seeded from the resource's own schema, the same way the
+// terraform-plugin-framework server does before invoking a provider's
+// ImportState method.
+func importState(t *testing.T, r *SecretStoreEntriesResource, id string) (SecretStoreEntriesModel, *resource.ImportStateResponse) {
+	t.Helper()
+
+	ctx := context.Background()
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	resp := &resource.ImportStateResponse{
+		State: tfsdk.State{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+		},
+	}
+
+	r.ImportState(ctx, resource.ImportStateRequest{ID: id}, resp)
+
+	var data SecretStoreEntriesModel
+	if !resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(resp.State.Get(ctx, &data)...)
+	}
+
+	return data, resp
+}
+
+func TestSecretStoreEntryImportState_StoreIDAndKey(t *testing.T) {
+	r := &SecretStoreEntriesResource{}
+
+	// The default "/" separator lets keys with dots, such as a DNS-style
+	// secret name, pass through untouched.
+	data, resp := importState(t, r, "store123/svc.prod.tls.key")
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %s", resp.Diagnostics)
+	}
+
+	if got, want := data.StoreID.ValueString(), "store123"; got != want {
+		t.Errorf("store_id = %q, want %q", got, want)
+	}
+	if got, want := data.Key.ValueString(), "svc.prod.tls.key"; got != want {
+		t.Errorf("key = %q, want %q", got, want)
+	}
+}
+
+func TestSecretStoreEntryImportState_ByStoreName(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/resources/stores/secret" {
+			t.Errorf("unexpected request path %q", req.URL.Path)
+		}
+		if got, want := req.URL.Query().Get("name"), "prod-secrets"; got != want {
+			t.Errorf("name query param = %q, want %q", got, want)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(fastly.SecretStores{
+			Data: []fastly.SecretStore{
+				{StoreID: "store456", Name: "prod-secrets"},
+			},
+		})
+	}))
+	defer server.Close()
+
+	client, err := fastly.NewClientForEndpoint("test-api-key", server.URL)
+	if err != nil {
+		t.Fatalf("failed to build test client: %v", err)
+	}
+
+	r := &SecretStoreEntriesResource{client: client}
+
+	data, resp := importState(t, r, "name/prod-secrets/svc.prod.tls.key")
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %s", resp.Diagnostics)
+	}
+
+	if got, want := data.StoreID.ValueString(), "store456"; got != want {
+		t.Errorf("store_id = %q, want %q", got, want)
+	}
+	if got, want := data.Key.ValueString(), "svc.prod.tls.key"; got != want {
+		t.Errorf("key = %q, want %q", got, want)
+	}
+}
+
+// TestSecretStoreEntryPrepareSecret_ClientEncryptedUsesPublicKey guards
+// against submitting the client key's signature (ed25519, 64 bytes) where
+// Fastly expects its public key (X25519, 32 bytes): CreateSecret would
+// either reject the secret outright or store it sealed against a public
+// key Fastly can't associate with what was posted.
+func TestSecretStoreEntryPrepareSecret_ClientEncryptedUsesPublicKey(t *testing.T) {
+	wantPublicKey := bytes.Repeat([]byte{0x42}, 32)
+	wantSignature := bytes.Repeat([]byte{0x24}, 64)
+
+	var gotClientKey []byte
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case req.Method == http.MethodPost && req.URL.Path == "/resources/stores/secret/client-key":
+			_ = json.NewEncoder(w).Encode(fastly.ClientKey{
+				PublicKey: wantPublicKey,
+				Signature: wantSignature,
+				ExpiresAt: time.Now().Add(time.Hour),
+			})
+		case req.Method == http.MethodPost && req.URL.Path == "/resources/stores/secret/store1/secrets":
+			var body struct {
+				ClientKey []byte `json:"client_key"`
+			}
+			if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+				t.Fatalf("failed to decode CreateSecret request body: %v", err)
+			}
+			gotClientKey = body.ClientKey
+
+			_ = json.NewEncoder(w).Encode(fastly.Secret{
+				Name:      "key1",
+				Digest:    []byte("digest"),
+				CreatedAt: time.Now(),
+			})
+		default:
+			t.Fatalf("unexpected request %s %s", req.Method, req.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client, err := fastly.NewClientForEndpoint("test-api-key", server.URL)
+	if err != nil {
+		t.Fatalf("failed to build test client: %v", err)
+	}
+
+	r := &SecretStoreEntriesResource{client: client}
+	data := SecretStoreEntriesModel{
+		Value: types.StringValue("secretum servare"),
+		Mode:  types.StringValue(secretModeClientEncrypted),
+	}
+
+	secretBytes, clientKeyPublic, err := r.prepareSecret(&data)
+	if err != nil {
+		t.Fatalf("prepareSecret: %v", err)
+	}
+	if got, want := base64.StdEncoding.EncodeToString(clientKeyPublic), base64.StdEncoding.EncodeToString(wantPublicKey); got != want {
+		t.Fatalf("prepareSecret returned client key = %s, want public key %s", got, want)
+	}
+
+	if _, err := client.CreateSecret(&fastly.CreateSecretInput{
+		StoreID:   "store1",
+		Name:      "key1",
+		Secret:    secretBytes,
+		ClientKey: clientKeyPublic,
+	}); err != nil {
+		t.Fatalf("CreateSecret: %v", err)
+	}
+
+	if got, want := base64.StdEncoding.EncodeToString(gotClientKey), base64.StdEncoding.EncodeToString(wantPublicKey); got != want {
+		t.Errorf("posted client_key = %s, want the client key's public key %s (not its signature)", got, want)
+	}
+}
+
+func TestSecretStoreEntryImportState_InvalidID(t *testing.T) {
+	r := &SecretStoreEntriesResource{}
+
+	_, resp := importState(t, r, "just-one-part")
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected a diagnostic error for a malformed import ID, got none")
+	}
+}
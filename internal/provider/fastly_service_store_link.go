@@ -0,0 +1,382 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/fastly/go-fastly/v9/fastly"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ServiceStoreLinkResource{}
+var _ resource.ResourceWithImportState = &ServiceStoreLinkResource{}
+
+func NewServiceStoreLinkResource() resource.Resource {
+	return &ServiceStoreLinkResource{}
+}
+
+// ServiceStoreLinkResource defines the resource implementation.
+//
+// Unlike the store and store entry resources, a store link is not itself a
+// Fastly object: it is the "resource" association recorded against a
+// specific service version, mirroring the versioned sub-resources that the
+// original hashicorp/terraform-provider-fastly modeled under
+// `fastly_service_v1`.
+type ServiceStoreLinkResource struct {
+	client *fastly.Client
+}
+
+// ServiceStoreLinkModel describes the resource data model.
+type ServiceStoreLinkModel struct {
+	ServiceID        types.String `tfsdk:"service_id"`
+	StoreID          types.String `tfsdk:"store_id"`
+	StoreType        types.String `tfsdk:"store_type"`
+	Name             types.String `tfsdk:"name"`
+	Activate         types.Bool   `tfsdk:"activate"`
+	ManageActivation types.Bool   `tfsdk:"manage_activation"`
+	ResourceID       types.String `tfsdk:"resource_id"`
+	ServiceVersion   types.Int64  `tfsdk:"service_version"`
+}
+
+// validateStoreType rejects anything other than the store_type values this
+// resource understands. The Fastly resource-link API infers the resource's
+// kind from store_id itself, so unlike earlier drafts of this resource there
+// is no resource_type value to pass along - this exists purely to catch
+// typos in store_type at apply time, before any service version is cloned,
+// instead of a confusing API error later.
+func validateStoreType(storeType string) error {
+	switch storeType {
+	case "secret", "config", "kv":
+		return nil
+	default:
+		return fmt.Errorf("unknown store_type %q, must be one of: secret, config, kv", storeType)
+	}
+}
+
+func (r *ServiceStoreLinkResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_store_link"
+}
+
+func (r *ServiceStoreLinkResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Links a Fastly SecretStore/ConfigStore/KVStore to a service version as a resource, optionally activating the resulting version.",
+
+		Attributes: map[string]schema.Attribute{
+			"service_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the Fastly service to attach the store to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"store_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the store (secret, config, or kv) to attach.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"store_type": schema.StringAttribute{
+				MarkdownDescription: "The kind of store being linked. One of `secret`, `config`, `kv`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name given to the resource link within the service version. Defaults to `store_id` when unset.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"activate": schema.BoolAttribute{
+				MarkdownDescription: "Whether the cloned version should be activated once the link is created. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"manage_activation": schema.BoolAttribute{
+				MarkdownDescription: "When `false`, the provider clones and links but never calls activate itself, so `terraform plan` can preview the change without it going live. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"resource_id": schema.StringAttribute{
+				MarkdownDescription: "The ID Fastly assigned to the resource link.",
+				Computed:            true,
+			},
+			"service_version": schema.Int64Attribute{
+				MarkdownDescription: "The service version the link was created (and, if activated, went live) on.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *ServiceStoreLinkResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*FastlyProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *FastlyProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// latestServiceVersion returns the active version of a service, or its
+// highest version number if none is active yet.
+func (r *ServiceStoreLinkResource) latestServiceVersion(serviceID string) (*fastly.Version, error) {
+	versions, err := r.client.ListVersions(&fastly.ListVersionsInput{ServiceID: serviceID})
+	if err != nil {
+		return nil, err
+	}
+	if len(versions) == 0 {
+		return nil, fmt.Errorf("service %s has no versions", serviceID)
+	}
+
+	latest := versions[0]
+	for _, v := range versions {
+		if v.Active != nil && *v.Active {
+			return v, nil
+		}
+		if fastly.ToValue(v.Number) > fastly.ToValue(latest.Number) {
+			latest = v
+		}
+	}
+	return latest, nil
+}
+
+func (r *ServiceStoreLinkResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ServiceStoreLinkModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Name.IsUnknown() || data.Name.ValueString() == "" {
+		data.Name = types.StringValue(data.StoreID.ValueString())
+	}
+	if data.Activate.IsUnknown() {
+		data.Activate = types.BoolValue(true)
+	}
+	if data.ManageActivation.IsUnknown() {
+		data.ManageActivation = types.BoolValue(true)
+	}
+
+	if err := validateStoreType(data.StoreType.ValueString()); err != nil {
+		resp.Diagnostics.AddError("invalid store_type", err.Error())
+		return
+	}
+
+	current, err := r.latestServiceVersion(data.ServiceID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to look up current service version", err.Error())
+		return
+	}
+
+	cloned, err := r.client.CloneVersion(&fastly.CloneVersionInput{
+		ServiceID:      data.ServiceID.ValueString(),
+		ServiceVersion: fastly.ToValue(current.Number),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("failed to clone service version", err.Error())
+		return
+	}
+	clonedVersion := fastly.ToValue(cloned.Number)
+
+	link, err := r.client.CreateResource(&fastly.CreateResourceInput{
+		ServiceID:      data.ServiceID.ValueString(),
+		ServiceVersion: clonedVersion,
+		Name:           fastly.ToPointer(data.Name.ValueString()),
+		ResourceID:     fastly.ToPointer(data.StoreID.ValueString()),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("failed to create service resource link", err.Error())
+		return
+	}
+
+	data.ResourceID = types.StringValue(fastly.ToValue(link.LinkID))
+	data.ServiceVersion = types.Int64Value(int64(clonedVersion))
+
+	if data.Activate.ValueBool() && data.ManageActivation.ValueBool() {
+		if _, err := r.client.ActivateVersion(&fastly.ActivateVersionInput{
+			ServiceID:      data.ServiceID.ValueString(),
+			ServiceVersion: clonedVersion,
+		}); err != nil {
+			resp.Diagnostics.AddError("failed to activate service version "+fmt.Sprint(clonedVersion), err.Error())
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ServiceStoreLinkResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ServiceStoreLinkModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Look at the highest-numbered version rather than the active one, so a
+	// link created with activation off is still found on its cloned version.
+	versions, err := r.client.ListVersions(&fastly.ListVersionsInput{ServiceID: data.ServiceID.ValueString()})
+	if err != nil {
+		resp.Diagnostics.AddError("failed to list service versions", err.Error())
+		return
+	}
+
+	latestVersion := 0
+	for _, v := range versions {
+		if n := fastly.ToValue(v.Number); n > latestVersion {
+			latestVersion = n
+		}
+	}
+	resources, err := r.client.ListResources(&fastly.ListResourcesInput{
+		ServiceID:      data.ServiceID.ValueString(),
+		ServiceVersion: latestVersion,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("failed to list service resource links", err.Error())
+		return
+	}
+
+	for _, res := range resources {
+		if fastly.ToValue(res.LinkID) == data.ResourceID.ValueString() {
+			if data.ServiceVersion.IsNull() {
+				// Set only after an import; otherwise keep the version the
+				// link was created on.
+				data.ServiceVersion = types.Int64Value(int64(latestVersion))
+			}
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			return
+		}
+	}
+
+	// The link is no longer present on the latest version of the service.
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *ServiceStoreLinkResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ServiceStoreLinkModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state ServiceStoreLinkModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// activate and manage_activation are the only attributes that can change
+	// without forcing replacement, and neither affects the link itself - but
+	// the version the link was created on still needs to be (de)activated to
+	// match, or flipping these on a later apply would silently do nothing.
+	data.ResourceID = state.ResourceID
+	data.ServiceVersion = state.ServiceVersion
+	serviceVersion := int(state.ServiceVersion.ValueInt64())
+	wasManaged := state.Activate.ValueBool() && state.ManageActivation.ValueBool()
+	isManaged := data.Activate.ValueBool() && data.ManageActivation.ValueBool()
+
+	switch {
+	case isManaged && !wasManaged:
+		if _, err := r.client.ActivateVersion(&fastly.ActivateVersionInput{
+			ServiceID:      data.ServiceID.ValueString(),
+			ServiceVersion: serviceVersion,
+		}); err != nil {
+			resp.Diagnostics.AddError("failed to activate service version "+fmt.Sprint(serviceVersion), err.Error())
+			return
+		}
+	case wasManaged && !isManaged && data.ManageActivation.ValueBool():
+		// manage_activation is still (or newly) true, only activate flipped
+		// to false: deactivate the version we previously activated.
+		if _, err := r.client.DeactivateVersion(&fastly.DeactivateVersionInput{
+			ServiceID:      data.ServiceID.ValueString(),
+			ServiceVersion: serviceVersion,
+		}); err != nil {
+			resp.Diagnostics.AddError("failed to deactivate service version "+fmt.Sprint(serviceVersion), err.Error())
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ServiceStoreLinkResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ServiceStoreLinkModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, err := r.latestServiceVersion(data.ServiceID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to look up current service version", err.Error())
+		return
+	}
+
+	cloned, err := r.client.CloneVersion(&fastly.CloneVersionInput{
+		ServiceID:      data.ServiceID.ValueString(),
+		ServiceVersion: fastly.ToValue(current.Number),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("failed to clone service version", err.Error())
+		return
+	}
+	clonedVersion := fastly.ToValue(cloned.Number)
+
+	err = r.client.DeleteResource(&fastly.DeleteResourceInput{
+		ServiceID:      data.ServiceID.ValueString(),
+		ServiceVersion: clonedVersion,
+		ResourceID:     data.ResourceID.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("failed to delete service resource link", err.Error())
+		return
+	}
+
+	if data.ManageActivation.ValueBool() {
+		if _, err := r.client.ActivateVersion(&fastly.ActivateVersionInput{
+			ServiceID:      data.ServiceID.ValueString(),
+			ServiceVersion: clonedVersion,
+		}); err != nil {
+			resp.Diagnostics.AddError("failed to activate service version "+fmt.Sprint(clonedVersion), err.Error())
+			return
+		}
+	}
+}
+
+func (r *ServiceStoreLinkResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, ".")
+	if len(parts) != 2 {
+		resp.Diagnostics.AddError(
+			"invalid import ID",
+			fmt.Sprintf("expected an import ID of the form \"service_id.resource_id\", got %q", req.ID),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("service_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("resource_id"), parts[1])...)
+}
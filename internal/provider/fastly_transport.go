@@ -0,0 +1,144 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"math/rand"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	defaultHTTPTimeout  = 30 * time.Second
+	defaultMaxRetries   = 3
+	defaultRetryMaxWait = 30 * time.Second
+)
+
+// retryingTransport wraps an http.RoundTripper so that Fastly API requests
+// which fail with a 429 or 5xx response are retried with exponential
+// backoff and jitter, instead of surfacing a spurious apply error for what
+// is usually a transient condition.
+type retryingTransport struct {
+	Base       http.RoundTripper
+	MaxRetries int
+	MaxWait    time.Duration
+}
+
+func (t *retryingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	var resp *http.Response
+	var err error
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 && req.Body != nil {
+			if req.GetBody == nil {
+				// The request body can't be replayed (e.g. it came from a
+				// plain io.Reader with no GetBody set), so retrying would
+				// silently send an empty body. Give up and return what we
+				// have instead of corrupting the request.
+				break
+			}
+			body, bodyErr := req.GetBody()
+			if bodyErr != nil {
+				err = bodyErr
+				break
+			}
+			req.Body = body
+		}
+
+		resp, err = base.RoundTrip(req)
+		if !t.shouldRetry(resp, err) {
+			break
+		}
+		if attempt >= t.MaxRetries {
+			// Retries exhausted and the request is still failing: surface
+			// the request ID Fastly returned on the final attempt so it
+			// reaches the diagnostic a practitioner actually sees, not just
+			// the retry log below.
+			requestID := ""
+			if resp != nil {
+				requestID = resp.Header.Get("X-Request-Id")
+				resp.Body.Close()
+			}
+			return nil, &retriesExhaustedError{requestID: requestID, resp: resp, err: err}
+		}
+
+		wait := t.backoff(attempt)
+		requestID := ""
+		if resp != nil {
+			requestID = resp.Header.Get("X-Request-Id")
+			resp.Body.Close()
+		}
+		tflog.Warn(req.Context(), "retrying fastly API request", map[string]interface{}{
+			"attempt":    attempt + 1,
+			"wait":       wait.String(),
+			"request_id": requestID,
+		})
+
+		select {
+		case <-time.After(wait):
+		case <-req.Context().Done():
+			return resp, req.Context().Err()
+		}
+	}
+
+	return resp, err
+}
+
+// retriesExhaustedError is returned once retryingTransport gives up, so that
+// the request ID from the final failing response (or the underlying
+// transport error) is visible in err.Error() wherever the caller surfaces
+// it - e.g. a resource's resp.Diagnostics.AddError(..., err.Error()) - rather
+// than only in the provider's debug log.
+type retriesExhaustedError struct {
+	requestID string
+	resp      *http.Response
+	err       error
+}
+
+func (e *retriesExhaustedError) Error() string {
+	switch {
+	case e.err != nil && e.requestID != "":
+		return fmt.Sprintf("%s (request id: %s)", e.err, e.requestID)
+	case e.err != nil:
+		return e.err.Error()
+	case e.requestID != "":
+		return fmt.Sprintf("fastly API request failed with %s after retries (request id: %s)", e.resp.Status, e.requestID)
+	default:
+		return fmt.Sprintf("fastly API request failed with %s after retries", e.resp.Status)
+	}
+}
+
+func (e *retriesExhaustedError) Unwrap() error {
+	return e.err
+}
+
+func (t *retryingTransport) shouldRetry(resp *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+	return resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500
+}
+
+// backoff returns an exponentially increasing wait, jittered by up to 50%
+// and capped at MaxWait.
+func (t *retryingTransport) backoff(attempt int) time.Duration {
+	wait := time.Duration(1<<uint(attempt)) * time.Second
+	if wait > t.MaxWait {
+		wait = t.MaxWait
+	}
+
+	jitter := time.Duration(rand.Int63n(int64(wait/2) + 1))
+	wait = wait/2 + jitter
+	if wait > t.MaxWait {
+		wait = t.MaxWait
+	}
+	return wait
+}
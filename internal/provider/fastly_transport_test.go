@@ -0,0 +1,43 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestRetryingTransport_ExhaustedRetriesSurfaceRequestID(t *testing.T) {
+	base := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusServiceUnavailable,
+			Status:     "503 Service Unavailable",
+			Header:     http.Header{"X-Request-Id": []string{"req-last-attempt"}},
+			Body:       io.NopCloser(strings.NewReader("")),
+		}, nil
+	})
+
+	transport := &retryingTransport{Base: base, MaxRetries: 2, MaxWait: 0}
+
+	req, err := http.NewRequest(http.MethodGet, "http://example.invalid/", nil)
+	if err != nil {
+		t.Fatalf("failed to build request: %v", err)
+	}
+
+	_, err = transport.RoundTrip(req)
+	if err == nil {
+		t.Fatal("expected an error once retries are exhausted, got none")
+	}
+	if !strings.Contains(err.Error(), "req-last-attempt") {
+		t.Errorf("err.Error() = %q, want it to contain the final response's request ID", err.Error())
+	}
+}
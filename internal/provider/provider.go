@@ -5,6 +5,9 @@ package provider
 
 import (
 	"context"
+	"net/http"
+	"os"
+	"time"
 
 	"github.com/fastly/go-fastly/v9/fastly"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -27,7 +30,20 @@ type StorytelFastlyProvider struct {
 
 // StorytelFastlyProviderModel describes the provider data model.
 type StorytelFastlyProviderModel struct {
-	APIKey types.String `tfsdk:"api_key"`
+	APIKey            types.String `tfsdk:"api_key"`
+	Endpoint          types.String `tfsdk:"endpoint"`
+	HTTPTimeout       types.String `tfsdk:"http_timeout"`
+	MaxRetries        types.Int64  `tfsdk:"max_retries"`
+	RetryMaxWait      types.String `tfsdk:"retry_max_wait"`
+	DefaultSecretMode types.String `tfsdk:"default_secret_mode"`
+}
+
+// FastlyProviderData is passed to resources and data sources via
+// resp.ResourceData/resp.DataSourceData so they can share the configured
+// client plus any provider-wide defaults without each one re-reading HCL.
+type FastlyProviderData struct {
+	Client            *fastly.Client
+	DefaultSecretMode string
 }
 
 func (p *StorytelFastlyProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -39,8 +55,29 @@ func (p *StorytelFastlyProvider) Schema(ctx context.Context, req provider.Schema
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"api_key": schema.StringAttribute{
-				MarkdownDescription: "Fastly API key",
-				Required:            true,
+				MarkdownDescription: "Fastly API key. Falls back to the `FASTLY_API_KEY` environment variable.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"endpoint": schema.StringAttribute{
+				MarkdownDescription: "Fastly API base URL. Falls back to the `FASTLY_API_URL` environment variable, then the go-fastly default.",
+				Optional:            true,
+			},
+			"http_timeout": schema.StringAttribute{
+				MarkdownDescription: "Per-request HTTP timeout, as a Go duration string (e.g. `30s`). Defaults to `30s`.",
+				Optional:            true,
+			},
+			"max_retries": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of retries for requests that fail with a `429` or `5xx` response. Defaults to `3`.",
+				Optional:            true,
+			},
+			"retry_max_wait": schema.StringAttribute{
+				MarkdownDescription: "Upper bound on the exponential backoff between retries, as a Go duration string. Defaults to `30s`.",
+				Optional:            true,
+			},
+			"default_secret_mode": schema.StringAttribute{
+				MarkdownDescription: "Module-wide default for `storytelfastly_secretstore_entry`'s `mode` attribute when a resource doesn't set it explicitly. One of `plaintext` or `client_encrypted`. Defaults to `plaintext`.",
+				Optional:            true,
 			},
 		},
 	}
@@ -53,9 +90,9 @@ func (p *StorytelFastlyProvider) Configure(ctx context.Context, req provider.Con
 
 	if data.APIKey.IsUnknown() {
 		resp.Diagnostics.AddAttributeError(
-			path.Root("api_Key"),
-			"Unknown Fastly SecretStore Entries API key",
-			"Fastly SecretStore Entries client cannot be created because the API key is unknown.",
+			path.Root("api_key"),
+			"Unknown Fastly API key",
+			"Fastly client cannot be created because the API key is unknown.",
 		)
 	}
 
@@ -63,18 +100,91 @@ func (p *StorytelFastlyProvider) Configure(ctx context.Context, req provider.Con
 		return
 	}
 
-	client, err := fastly.NewClient(data.APIKey.ValueString())
+	apiKey := data.APIKey.ValueString()
+	if apiKey == "" {
+		apiKey = os.Getenv("FASTLY_API_KEY")
+	}
+	if apiKey == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("api_key"),
+			"Missing Fastly API key",
+			"Set `api_key` on the provider or export FASTLY_API_KEY.",
+		)
+		return
+	}
+
+	endpoint := data.Endpoint.ValueString()
+	if endpoint == "" {
+		endpoint = os.Getenv("FASTLY_API_URL")
+	}
+
+	httpTimeout := defaultHTTPTimeout
+	if v := data.HTTPTimeout.ValueString(); v != "" {
+		parsed, err := time.ParseDuration(v)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("http_timeout"), "invalid http_timeout", err.Error())
+			return
+		}
+		httpTimeout = parsed
+	}
+
+	maxRetries := defaultMaxRetries
+	if !data.MaxRetries.IsNull() {
+		maxRetries = int(data.MaxRetries.ValueInt64())
+	}
+
+	retryMaxWait := defaultRetryMaxWait
+	if v := data.RetryMaxWait.ValueString(); v != "" {
+		parsed, err := time.ParseDuration(v)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("retry_max_wait"), "invalid retry_max_wait", err.Error())
+			return
+		}
+		retryMaxWait = parsed
+	}
+
+	var client *fastly.Client
+	var err error
+	if endpoint != "" {
+		client, err = fastly.NewClientForEndpoint(apiKey, endpoint)
+	} else {
+		client, err = fastly.NewClient(apiKey)
+	}
 	if err != nil {
-		resp.Diagnostics.AddError("failed to initialize fastly client", "")
+		resp.Diagnostics.AddError("failed to initialize fastly client", err.Error())
 		return
 	}
-	resp.DataSourceData = client
-	resp.ResourceData = client
+
+	client.HTTPClient = &http.Client{
+		Timeout: httpTimeout,
+		Transport: &retryingTransport{
+			Base:       client.HTTPClient.Transport,
+			MaxRetries: maxRetries,
+			MaxWait:    retryMaxWait,
+		},
+	}
+
+	defaultSecretMode := data.DefaultSecretMode.ValueString()
+	if defaultSecretMode == "" {
+		defaultSecretMode = "plaintext"
+	}
+
+	providerData := &FastlyProviderData{
+		Client:            client,
+		DefaultSecretMode: defaultSecretMode,
+	}
+	resp.DataSourceData = providerData
+	resp.ResourceData = providerData
 }
 
 func (p *StorytelFastlyProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewSecretStoreEntriesResource,
+		NewConfigStoreResource,
+		NewConfigStoreEntryResource,
+		NewKVStoreResource,
+		NewKVStoreEntryResource,
+		NewServiceStoreLinkResource,
 	}
 }
 
@@ -83,7 +193,11 @@ func (p *StorytelFastlyProvider) EphemeralResources(ctx context.Context) []func(
 }
 
 func (p *StorytelFastlyProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
-	return []func() datasource.DataSource{}
+	return []func() datasource.DataSource{
+		NewSecretStoreDataSource,
+		NewSecretStoreEntryDataSource,
+		NewSecretStoreEntriesDataSource,
+	}
 }
 
 func (p *StorytelFastlyProvider) Functions(ctx context.Context) []func() function.Function {